@@ -0,0 +1,80 @@
+package iban
+
+import "testing"
+
+// TestGenerate checks that Generate computes check digits that NewIBAN
+// then accepts, for a short and a long country.
+func TestGenerate(t *testing.T) {
+	cases := []struct {
+		country string
+		bban    string
+		want    string
+	}{
+		{"BE", "539007547034", "BE68539007547034"},
+		{"MT", "MALT011000012345MTLCAST001S", "MT84MALT011000012345MTLCAST001S"},
+	}
+
+	for _, c := range cases {
+		iban, err := Generate(c.country, c.bban)
+		if err != nil {
+			t.Fatalf("%s: %v", c.country, err)
+		}
+		if iban.code != c.want {
+			t.Fatalf("%s: got %s, want %s", c.country, iban.code, c.want)
+		}
+		if _, err := NewIBAN(iban.code); err != nil {
+			t.Fatalf("%s: generated IBAN %s rejected by NewIBAN: %v", c.country, iban.code, err)
+		}
+	}
+}
+
+// TestRandomIBAN checks that RandomIBAN produces a format-conformant,
+// check-digit-valid IBAN for every registered country.
+func TestRandomIBAN(t *testing.T) {
+	for code := range countries {
+		iban, err := RandomIBAN(code)
+		if err != nil {
+			t.Fatalf("%s: %v", code, err)
+		}
+		if _, err := NewIBAN(iban.code); err != nil {
+			t.Fatalf("%s: random IBAN %s rejected by NewIBAN: %v", code, iban.code, err)
+		}
+	}
+}
+
+// TestComposeRoundTrip checks that Compose lays BBANParts out correctly and
+// produces a valid IBAN, for a sample of countries whose Fields tile the
+// whole bban. Countries with gaps (e.g. Seychelles) are expected to error instead;
+// see TestComposeGap.
+func TestComposeRoundTrip(t *testing.T) {
+	cases := []struct {
+		country string
+		parts   BBANParts
+	}{
+		{"BE", BBANParts{BankCode: "539", AccountNumber: "0075470", NationalCheckDigit: "34"}},
+		{"FI", BBANParts{BankCode: "123456", AccountNumber: "0007854", NationalCheckDigit: "1"}},
+		{"IS", BBANParts{BankCode: "0159", BranchCode: "26", AccountNumber: "007654", NationalCheckDigit: "5510730339"}},
+		{"MN", BBANParts{BankCode: "1234", AccountNumber: "567890123456"}},
+		{"BY", BBANParts{BankCode: "ABCD", BranchCode: "1234", AccountNumber: "0000000000123456"}},
+	}
+
+	for _, c := range cases {
+		iban, err := Compose(c.country, c.parts)
+		if err != nil {
+			t.Fatalf("%s: Compose: %v", c.country, err)
+		}
+		if _, err := NewIBAN(iban.code); err != nil {
+			t.Fatalf("%s: composed IBAN %s rejected by NewIBAN: %v", c.country, iban.code, err)
+		}
+	}
+}
+
+// TestComposeGap checks that Compose refuses to build an IBAN for a country
+// whose Fields leave part of the bban uncovered, rather than silently
+// filling the gap with zero bytes.
+func TestComposeGap(t *testing.T) {
+	_, err := Compose("SC", BBANParts{BankCode: "SSCB", BranchCode: "1101", AccountNumber: "0000000000001497"})
+	if err == nil {
+		t.Fatal("expected an error composing SC, whose Fields leave the trailing currency code uncovered")
+	}
+}
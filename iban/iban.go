@@ -1,6 +1,7 @@
 package iban
 
 import (
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"math/big"
@@ -9,6 +10,66 @@ import (
 	"strings"
 )
 
+// Names for the fields a country's BBAN can be decomposed into. Used as the
+// Name of a FieldSpec and as the key callers match against when reading a
+// BBANParts value.
+const (
+	FieldBankCode           = "BankCode"
+	FieldBranchCode         = "BranchCode"
+	FieldAccountNumber      = "AccountNumber"
+	FieldNationalCheckDigit = "NationalCheckDigit"
+)
+
+// Sentinel errors identifying which check an IBAN failed. Callers can test
+// for a specific failure with errors.Is, e.g. errors.Is(err, iban.ErrCheckDigits).
+var (
+	ErrCharset            = errors.New("IBAN can contain only alphanumeric characters")
+	ErrCountryUnsupported = errors.New("unsupported country code")
+	ErrLength             = errors.New("IBAN length does not match length specified for country")
+	ErrBBANFormat         = errors.New("bban part of IBAN is not formatted according to country specification")
+	ErrCheckDigits        = errors.New("IBAN has incorrect check digits")
+)
+
+// fieldError wraps ErrBBANFormat with the name of the bban field that
+// failed to match, when the country's CountrySettings.Fields identifies it.
+type fieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *fieldError) Error() string { return fmt.Sprintf("%s: %v", e.Field, e.Err) }
+func (e *fieldError) Unwrap() error { return e.Err }
+
+// ValidationError aggregates the failures found while validating an IBAN.
+// It implements Unwrap() []error (Go 1.20+), so errors.Is and errors.As see
+// through to every underlying sentinel, e.g. errors.Is(err, iban.ErrCheckDigits).
+type ValidationError struct {
+	Errs []error
+}
+
+func (v *ValidationError) Error() string {
+	msgs := make([]string, len(v.Errs))
+	for i, err := range v.Errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (v *ValidationError) Unwrap() []error { return v.Errs }
+
+// FieldSpec describes where a named part of the BBAN lives, as a
+// start/end slice range (end exclusive) into the bban string.
+type FieldSpec struct {
+	// Name of the field, one of the Field* constants
+	Name string
+
+	// Start offset of the field within the bban, inclusive
+	Start int
+
+	// End offset of the field within the bban, exclusive
+	End int
+}
+
 // countrySettings contains length for IBAN and format for bban
 type CountrySettings struct {
 	// Length of IBAN code for this country
@@ -19,6 +80,21 @@ type CountrySettings struct {
 
 	// Membership of country
 	Sepa bool
+
+	// Fields describes the named parts the bban for this country can be
+	// decomposed into. Optional: countries for which the breakdown is not
+	// yet described have a nil Fields and yield a zero-value BBANParts.
+	Fields []FieldSpec
+}
+
+// BBANParts is the structured decomposition of a BBAN into its named
+// components, as described by a country's CountrySettings.Fields. Fields
+// that are not defined for a given country are left as the empty string.
+type BBANParts struct {
+	BankCode           string
+	BranchCode         string
+	AccountNumber      string
+	NationalCheckDigit string
 }
 
 // IBAN struct
@@ -40,136 +116,162 @@ type IBAN struct {
 
 	// Country specific bban part
 	bban string
-}
 
-/*
-	Taken from http://www.tbg5-finance.org/ code example
-*/
-var countries = map[string]CountrySettings{
-	"AD": CountrySettings{Length: 24, Format: "F04F04A12", 		Sepa: false},
-	"AE": CountrySettings{Length: 23, Format: "F03F16", 		Sepa: false},
-	"AL": CountrySettings{Length: 28, Format: "F08A16", 		Sepa: false}, //8!n16!c
-	"AT": CountrySettings{Length: 20, Format: "F05F11", 		Sepa: true},
-	"AZ": CountrySettings{Length: 28, Format: "U04A20", 		Sepa: false},
-	"BA": CountrySettings{Length: 20, Format: "F03F03F08F02", 	Sepa: false},
-	"BE": CountrySettings{Length: 16, Format: "F03F07F02", 		Sepa: true},
-	"BG": CountrySettings{Length: 22, Format: "U04F04F02A08", 	Sepa: true},
-	"BH": CountrySettings{Length: 22, Format: "U04A14", 		Sepa: false},
-	"BR": CountrySettings{Length: 29, Format: "F08F05F10U01A01", Sepa: false},
-	"CH": CountrySettings{Length: 21, Format: "F05A12", 		Sepa: true},
-	"CR": CountrySettings{Length: 21, Format: "F03F14", 		Sepa: false},
-	"CY": CountrySettings{Length: 28, Format: "F03F05A16", 		Sepa: false},
-	"CZ": CountrySettings{Length: 24, Format: "F04F06F10", 		Sepa: true},
-	"DE": CountrySettings{Length: 22, Format: "F08F10", 		Sepa: true},
-	"DK": CountrySettings{Length: 18, Format: "F04F09F01", 		Sepa: true},
-	"DO": CountrySettings{Length: 28, Format: "U04F20", 		Sepa: false},
-	"EE": CountrySettings{Length: 20, Format: "F02F02F11F01", 	Sepa: true},
-	"ES": CountrySettings{Length: 24, Format: "F04F04F01F01F10", Sepa: true},
-	"FI": CountrySettings{Length: 18, Format: "F06F07F01", 		Sepa: true},
-	"FO": CountrySettings{Length: 18, Format: "F04F09F01", 		Sepa: true},
-	"FR": CountrySettings{Length: 27, Format: "F05F05A11F02", 	Sepa: true},
-	"GB": CountrySettings{Length: 22, Format: "U04F06F08", 		Sepa:true},
-	"GE": CountrySettings{Length: 22, Format: "U02F16", 		Sepa:false},
-	"GI": CountrySettings{Length: 23, Format: "U04A15", 		Sepa:true},
-	"GL": CountrySettings{Length: 18, Format: "F04F09F01", 		Sepa:true},
-	"GR": CountrySettings{Length: 27, Format: "F03F04A16", 		Sepa:true},
-	"GT": CountrySettings{Length: 28, Format: "A04A20", 		Sepa:false},
-	"HR": CountrySettings{Length: 21, Format: "F07F10", 		Sepa:false},
-	"HU": CountrySettings{Length: 28, Format: "F03F04F01F15F01", Sepa:true},
-	"IE": CountrySettings{Length: 22, Format: "U04F06F08", 		Sepa:true},
-	"IL": CountrySettings{Length: 23, Format: "F03F03F13", 		Sepa:false},
-	"IS": CountrySettings{Length: 26, Format: "F04F02F06F10", 	Sepa:true},
-	"IT": CountrySettings{Length: 27, Format: "U01F05F05A12", 	Sepa:true},
-	"JO": CountrySettings{Length: 30, Format: "U04F04A18", 		Sepa:false},
-	"KW": CountrySettings{Length: 30, Format: "U04A22", 		Sepa:false},
-	"KZ": CountrySettings{Length: 20, Format: "F03A13", 		Sepa:false},
-	"LB": CountrySettings{Length: 28, Format: "F04A20", 		Sepa:false},
-	"LC": CountrySettings{Length: 32, Format: "U04A24", 		Sepa:false},
-	"LI": CountrySettings{Length: 21, Format: "F05A12", 		Sepa:true},
-	"LT": CountrySettings{Length: 20, Format: "F05F11", 		Sepa:true},
-	"LU": CountrySettings{Length: 20, Format: "F03A13", 		Sepa:true},
-	"LV": CountrySettings{Length: 21, Format: "U04A13", 		Sepa:true},
-	"MC": CountrySettings{Length: 27, Format: "F05F05A11F02", 	Sepa:true},
-	"MD": CountrySettings{Length: 24, Format: "A20", 			Sepa:false},
-	"ME": CountrySettings{Length: 22, Format: "F03F13F02", 		Sepa:false},
-	"MK": CountrySettings{Length: 19, Format: "F03A10F02", 		Sepa:false},
-	"MR": CountrySettings{Length: 27, Format: "F05F05F11F02", 	Sepa:false},
-	"MT": CountrySettings{Length: 31, Format: "U04F05A18", 		Sepa:true},
-	"MU": CountrySettings{Length: 30, Format: "U04F02F02F12F03U03", Sepa:false},
-	"NL": CountrySettings{Length: 18, Format: "U04F10", 		Sepa:true},
-	"NO": CountrySettings{Length: 15, Format: "F04F06F01", 		Sepa:true},
-	"PK": CountrySettings{Length: 24, Format: "U04A16", 		Sepa:false},
-	"PL": CountrySettings{Length: 28, Format: "F08F16", 		Sepa:true},
-	"PS": CountrySettings{Length: 29, Format: "U04A21", 		Sepa:false},
-	"PT": CountrySettings{Length: 25, Format: "F04F04F11F02", 	Sepa:true},
-	"QA": CountrySettings{Length: 29, Format: "U04A21", 		Sepa:false},
-	"RO": CountrySettings{Length: 24, Format: "U04A16", 		Sepa:true},
-	"RS": CountrySettings{Length: 22, Format: "F03F13F02", 		Sepa:false},
-	"SA": CountrySettings{Length: 24, Format: "F02A18", 		Sepa:false},
-	"SC": CountrySettings{Length: 31, Format: "U04F02F02F16U03", Sepa:false},
-	"SE": CountrySettings{Length: 24, Format: "F03F16F01", 		Sepa:true},
-	"SI": CountrySettings{Length: 19, Format: "F05F08F02", 		Sepa:true},
-	"SK": CountrySettings{Length: 24, Format: "F04F06F10", 		Sepa:true},
-	"SM": CountrySettings{Length: 27, Format: "U01F05F05A12", 	Sepa:true},
-	"ST": CountrySettings{Length: 25, Format: "F08F11F02", 		Sepa:false},
-	"TL": CountrySettings{Length: 23, Format: "F03F14F02", 		Sepa:false},
-	"TN": CountrySettings{Length: 24, Format: "F02F03F13F02", 	Sepa:false},
-	"TR": CountrySettings{Length: 26, Format: "F05A01A16", 		Sepa:false},
-	"UA": CountrySettings{Length: 29, Format: "F06A19", 		Sepa:false},
-	"VG": CountrySettings{Length: 24, Format: "U04F16", 		Sepa:false},
-	"XK": CountrySettings{Length: 20, Format: "F04F10F02", 		Sepa:false},
+	// Structured decomposition of bban, populated from countrySettings.Fields
+	parts BBANParts
 }
 
-func (i *IBAN)Validate() (error) {
-	err1 := i.validateBban()
-	err2 := i.validateCheckDigits()
-	err := ""
-	if err1 != nil {
-		err = err + err1.Error()
+// The countries registry (the `countries` map) is generated from
+// registry/countries.json by registry/gen; see countries_gen.go. To add or
+// update a country, edit that descriptor and re-run go generate rather than
+// hand-editing the generated file.
+//go:generate go run ../registry/gen -input ../registry/countries.json -output countries_gen.go
+
+func (i *IBAN) Validate() error {
+	var errs []error
+	if err := i.validateBban(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := i.validateCheckDigits(); err != nil {
+		errs = append(errs, err)
 	}
-	if err2 != nil{
-		err = err + err2.Error()
+
+	if len(errs) == 0 {
+		return nil
 	}
-	return errors.New(err)
+
+	return &ValidationError{Errs: errs}
 }
 
-func (i *IBAN)PrintCode() string {
+func (i *IBAN) PrintCode() string {
 	return i.printCode
 }
 
+// Parts returns the structured decomposition of the bban part of the IBAN,
+// as described by the country's CountrySettings.Fields. Fields that are not
+// defined for the IBAN's country are left as the empty string.
+func (i *IBAN) Parts() BBANParts {
+	return i.parts
+}
+
+// Format renders the IBAN according to layout, a template in which the
+// placeholders {country}, {check}, {bank}, {branch}, {account} and
+// {national} are replaced by the corresponding parts of the IBAN, e.g.
+// i.Format("{country} {check} {bank} {branch} {account}").
+func (i *IBAN) Format(layout string) string {
+	r := strings.NewReplacer(
+		"{country}", i.countryCode,
+		"{check}", i.checkDigits,
+		"{bank}", i.parts.BankCode,
+		"{branch}", i.parts.BranchCode,
+		"{account}", i.parts.AccountNumber,
+		"{national}", i.parts.NationalCheckDigit,
+	)
+	return r.Replace(layout)
+}
+
+// bbanParts decomposes bban into a BBANParts using the given field
+// specifications. Fields are assumed to already have been validated against
+// the country's bban format, so offsets are not bounds-checked again here.
+func bbanParts(bban string, fields []FieldSpec) BBANParts {
+	var parts BBANParts
+	for _, f := range fields {
+		value := bban[f.Start:f.End]
+		switch f.Name {
+		case FieldBankCode:
+			parts.BankCode = value
+		case FieldBranchCode:
+			parts.BranchCode = value
+		case FieldAccountNumber:
+			parts.AccountNumber = value
+		case FieldNationalCheckDigit:
+			parts.NationalCheckDigit = value
+		}
+	}
+	return parts
+}
+
+// matchesClass reports whether every character of s belongs to the bban
+// format class identified by letter (F, L, U, A, B, C or W), matching the
+// same character sets as the F/L/U/A/B/C/W groups in CountrySettings.Format.
+func matchesClass(s, class string) bool {
+	for _, c := range s {
+		var ok bool
+		switch class {
+		case "F":
+			ok = c >= '0' && c <= '9'
+		case "L":
+			ok = c >= 'a' && c <= 'z'
+		case "U":
+			ok = c >= 'A' && c <= 'Z'
+		case "A":
+			ok = (c >= '0' && c <= '9') || (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z')
+		case "B":
+			ok = (c >= '0' && c <= '9') || (c >= 'A' && c <= 'Z')
+		case "C":
+			ok = (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z')
+		case "W":
+			ok = (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z')
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
 
-func (i *IBAN)validateCheckDigits() error {
-	// Move the four initial characters to the end of the string
-	iban := i.code[4:] + i.code[:4]
-	// Replace each letter in the string with two digits, thereby expanding the string, where A = 10, B = 11, ..., Z = 35
-	mods := ""
-	for _, c := range iban {
-		// Get character code point value
-		i := int(c)
-
-		// Check if c is characters A-Z (codepoint 65 - 90)
-		if i > 64 && i < 91 {
-			// A=10, B=11 etc...
-			i -= 55
-			// Add int as string to mod string
-			mods += strconv.Itoa(i)
-		} else {
-			mods += string(c)
+// uncoveredRanges reports the [start, end) byte ranges of a bban of the
+// given length that no field in fields covers. A non-empty result means
+// fields do not tile the bban, and so cannot be used to compose one.
+func uncoveredRanges(fields []FieldSpec, length int) [][2]int {
+	covered := make([]bool, length)
+	for _, f := range fields {
+		for i := f.Start; i < f.End && i < length; i++ {
+			covered[i] = true
 		}
 	}
 
-	// Create bignum from mod string and perform module
-	bigVal, success := new(big.Int).SetString(mods, 10)
-	if !success {
-		return errors.New("IBAN check digits validation failed")
+	var gaps [][2]int
+	for i := 0; i < length; {
+		if covered[i] {
+			i++
+			continue
+		}
+		start := i
+		for i < length && !covered[i] {
+			i++
+		}
+		gaps = append(gaps, [2]int{start, i})
 	}
 
-	modVal := new(big.Int).SetInt64(97)
-	resVal := new(big.Int).Mod(bigVal, modVal)
+	return gaps
+}
 
-	// Check if module is equal to 1
-	if resVal.Int64() != 1 {
-		return errors.New("IBAN has incorrect check digits")
+// fieldErrorFor returns ErrBBANFormat, wrapped with the name of the field
+// at [start, start+width) if the country's Fields describe one exactly
+// covering that range.
+func fieldErrorFor(start, width int, fields []FieldSpec) error {
+	for _, f := range fields {
+		if f.Start == start && f.End == start+width {
+			return &fieldError{Field: f.Name, Err: ErrBBANFormat}
+		}
+	}
+	return ErrBBANFormat
+}
+
+func (i *IBAN) validateCheckDigits() error {
+	// Move the four initial characters to the end of the string and compute
+	// the mod-97 remainder of its numeric expansion in a single streaming
+	// pass, without ever materializing the expanded string or allocating a
+	// big.Int.
+	rem, err := mod97(i.code[4:] + i.code[:4])
+	if err != nil {
+		return ErrCheckDigits
+	}
+
+	// Check if remainder is equal to 1
+	if rem != 1 {
+		return ErrCheckDigits
 	}
 
 	return nil
@@ -188,26 +290,13 @@ func (i *IBAN) validateBban() error {
 	// Get format part strings
 	fps := frx.FindAllString(format, -1)
 
-	// Create regex from format parts
-	bbr := ""
-
+	// Validate each group in turn, so that on failure we can report which
+	// named field (per countrySettings.Fields, if described) didn't match.
+	// Matched directly against the character class rather than via a
+	// per-group compiled regex, since the classes are a small fixed set.
+	offset := 0
 	for _, ps := range fps {
-		switch ps[:1] {
-		case "F":
-			bbr += "[0-9]"
-		case "L":
-			bbr += "[a-z]"
-		case "U":
-			bbr += "[A-Z]"
-		case "A":
-			bbr += "[0-9A-Za-z]"
-		case "B":
-			bbr += "[0-9A-Z]"
-		case "C":
-			bbr += "[A-Za-z]"
-		case "W":
-			bbr += "[0-9a-z]"
-		}
+		class := ps[:1]
 
 		// Get repeat factor for group
 		repeat, atoiErr := strconv.Atoi(ps[1:])
@@ -215,18 +304,12 @@ func (i *IBAN) validateBban() error {
 			return fmt.Errorf("Failed to validate bban: %v", atoiErr.Error())
 		}
 
-		// Add to regex
-		bbr += fmt.Sprintf("{%d}", repeat)
-	}
-
-	// Compile regex and validate bban
-	bbrx, err := regexp.Compile(bbr)
-	if err != nil {
-		return fmt.Errorf("Failed to validate bban: %v", err.Error())
-	}
+		end := offset + repeat
+		if end > len(bban) || !matchesClass(bban[offset:end], class) {
+			return fieldErrorFor(offset, repeat, i.countrySettings.Fields)
+		}
 
-	if !bbrx.MatchString(bban) {
-		return errors.New("bban part of IBAN is not formatted according to country specification")
+		offset = end
 	}
 
 	return nil
@@ -247,7 +330,7 @@ func NewIBAN(s string) (*IBAN, error) {
 	}
 
 	if !r.MatchString(s) {
-		return nil, errors.New("IBAN can contain only alphanumeric characters")
+		return nil, ErrCharset
 	}
 
 	// Get country code and check digits
@@ -267,14 +350,14 @@ func NewIBAN(s string) (*IBAN, error) {
 	// Get country settings for country code
 	cs, ok := countries[iban.countryCode]
 	if !ok {
-		return nil, fmt.Errorf("Unsupported country code %v", iban.countryCode)
+		return nil, fmt.Errorf("%w %v", ErrCountryUnsupported, iban.countryCode)
 	}
 
 	iban.countrySettings = &cs
 
 	// Validate code length
 	if len(s) != cs.Length {
-		return nil, fmt.Errorf("IBAN length %d does not match length %d specified for country code %v", len(s), cs.Length, iban.countryCode)
+		return nil, fmt.Errorf("%w: %d does not match length %d specified for country code %v", ErrLength, len(s), cs.Length, iban.countryCode)
 	}
 
 	// Set and validate bban part, the part after the language code and check digits
@@ -291,6 +374,9 @@ func NewIBAN(s string) (*IBAN, error) {
 		return nil, err
 	}
 
+	// Decompose bban into its named parts, if the country describes them
+	iban.parts = bbanParts(iban.bban, cs.Fields)
+
 	// Generate print code from code (splits code in sections of 4 characters)
 	prc := ""
 	for len(s) > 4 {
@@ -302,3 +388,176 @@ func NewIBAN(s string) (*IBAN, error) {
 
 	return &iban, nil
 }
+
+// Generate builds a valid IBAN for countryCode from bban, computing the two
+// check digits per ISO 13616. bban must already be formatted according to
+// the country's specification (see CountrySettings.Format), check digits
+// excluded.
+func Generate(countryCode, bban string) (*IBAN, error) {
+	countryCode = strings.ToUpper(countryCode)
+	bban = strings.ToUpper(bban)
+
+	if _, ok := countries[countryCode]; !ok {
+		return nil, fmt.Errorf("%w %v", ErrCountryUnsupported, countryCode)
+	}
+
+	checkDigits, err := computeCheckDigits(countryCode, bban)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewIBAN(countryCode + checkDigits + bban)
+}
+
+// Compose builds a valid IBAN for countryCode from a structured BBANParts,
+// using the country's CountrySettings.Fields to lay the parts out into a
+// bban before computing check digits. Returns an error if the country has
+// no registered Fields, if its Fields leave part of the bban uncovered, or
+// if a part does not have the exact width its field requires.
+func Compose(countryCode string, parts BBANParts) (*IBAN, error) {
+	countryCode = strings.ToUpper(countryCode)
+
+	cs, ok := countries[countryCode]
+	if !ok {
+		return nil, fmt.Errorf("%w %v", ErrCountryUnsupported, countryCode)
+	}
+
+	if len(cs.Fields) == 0 {
+		return nil, fmt.Errorf("country code %v does not define structured bban fields", countryCode)
+	}
+
+	if gaps := uncoveredRanges(cs.Fields, cs.Length-4); len(gaps) > 0 {
+		return nil, fmt.Errorf("country code %v bban fields do not cover the full bban, uncovered ranges %v: Compose is not supported for this country", countryCode, gaps)
+	}
+
+	bban := make([]byte, cs.Length-4)
+	for _, f := range cs.Fields {
+		var value string
+		switch f.Name {
+		case FieldBankCode:
+			value = parts.BankCode
+		case FieldBranchCode:
+			value = parts.BranchCode
+		case FieldAccountNumber:
+			value = parts.AccountNumber
+		case FieldNationalCheckDigit:
+			value = parts.NationalCheckDigit
+		}
+
+		width := f.End - f.Start
+		if len(value) != width {
+			return nil, fmt.Errorf("%v must be %d characters for country code %v, got %d", f.Name, width, countryCode, len(value))
+		}
+
+		copy(bban[f.Start:f.End], value)
+	}
+
+	return Generate(countryCode, string(bban))
+}
+
+// RandomIBAN generates a random, format-conformant and check-digit-valid
+// IBAN for countryCode, useful for tests and fixtures.
+func RandomIBAN(countryCode string) (*IBAN, error) {
+	countryCode = strings.ToUpper(countryCode)
+
+	cs, ok := countries[countryCode]
+	if !ok {
+		return nil, fmt.Errorf("%w %v", ErrCountryUnsupported, countryCode)
+	}
+
+	bban, err := randomBban(cs.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	return Generate(countryCode, bban)
+}
+
+// computeCheckDigits computes the two ISO 13616 check digits for the IBAN
+// formed by countryCode and bban, using the standard streaming mod-97
+// approach so that no big.Int allocation is needed.
+func computeCheckDigits(countryCode, bban string) (string, error) {
+	rem, err := mod97(bban + countryCode + "00")
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%02d", 98-rem), nil
+}
+
+// mod97 computes the remainder modulo 97 of the numeric string obtained by
+// expanding each letter of s into two digits (A=10, B=11, ..., Z=35), as
+// used throughout ISO 13616. It streams over s character by character,
+// keeping a bounded accumulator instead of materializing the expanded
+// string as a big.Int.
+func mod97(s string) (int, error) {
+	acc := 0
+	for _, c := range s {
+		switch {
+		case c >= '0' && c <= '9':
+			acc = (acc*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			acc = (acc*100 + int(c-'A'+10)) % 97
+		default:
+			return 0, fmt.Errorf("unexpected character %q in IBAN", c)
+		}
+	}
+
+	return acc, nil
+}
+
+// randomBban generates a random bban string conformant to format, using
+// crypto/rand so the result is suitable as a test fixture.
+func randomBban(format string) (string, error) {
+	frx := regexp.MustCompile(`[ABCFLUW]\d{2}`)
+	fps := frx.FindAllString(format, -1)
+
+	var sb strings.Builder
+	for _, ps := range fps {
+		repeat, err := strconv.Atoi(ps[1:])
+		if err != nil {
+			return "", fmt.Errorf("Failed to generate bban: %v", err.Error())
+		}
+
+		for n := 0; n < repeat; n++ {
+			c, err := randomChar(ps[:1])
+			if err != nil {
+				return "", err
+			}
+			sb.WriteByte(c)
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// randomChar picks a uniformly random character from the charset
+// identified by a format group kind (F, L, U, A, B, C or W).
+func randomChar(kind string) (byte, error) {
+	var charset string
+	switch kind {
+	case "F":
+		charset = "0123456789"
+	case "L":
+		charset = "abcdefghijklmnopqrstuvwxyz"
+	case "U":
+		charset = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	case "A":
+		charset = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	case "B":
+		charset = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	case "C":
+		charset = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	case "W":
+		charset = "0123456789abcdefghijklmnopqrstuvwxyz"
+	default:
+		return 0, fmt.Errorf("unknown bban format group %q", kind)
+	}
+
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(charset))))
+	if err != nil {
+		return 0, err
+	}
+
+	return charset[n.Int64()], nil
+}
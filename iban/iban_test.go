@@ -0,0 +1,66 @@
+package iban
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestValidateSentinelErrors checks that Validate's ValidationError unwraps
+// to the sentinel identifying the failed check, and that a bban field
+// mismatch is reported against the named field.
+func TestValidateSentinelErrors(t *testing.T) {
+	good, err := NewIBAN("BE68539007547034")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := good.Validate(); err != nil {
+		t.Fatalf("expected valid IBAN to validate cleanly, got %v", err)
+	}
+
+	bad, err := NewIBAN("BE68539007547034")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bad.code = "BE99" + bad.code[4:]
+	if err := bad.Validate(); !errors.Is(err, ErrCheckDigits) {
+		t.Fatalf("expected ErrCheckDigits, got %v", err)
+	}
+
+	_, err = NewIBAN("BE68ABC007547034")
+	if !errors.Is(err, ErrBBANFormat) {
+		t.Fatalf("expected ErrBBANFormat, got %v", err)
+	}
+	var fe *fieldError
+	if !errors.As(err, &fe) || fe.Field != FieldBankCode {
+		t.Fatalf("expected fieldError for %s, got %#v", FieldBankCode, err)
+	}
+}
+
+// BenchmarkValidate measures the streaming mod-97 check digit validation
+// path for a short (BE, 16 characters) and a long (MT, 31 characters) IBAN.
+func BenchmarkValidate(b *testing.B) {
+	cases := []struct {
+		name string
+		code string
+	}{
+		{"BE", "BE68539007547034"},
+		{"MT", "MT84MALT011000012345MTLCAST001S"},
+	}
+
+	for _, c := range cases {
+		b.Run(c.name, func(b *testing.B) {
+			iban, err := NewIBAN(c.code)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				if err := iban.validateCheckDigits(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package iban
+
+import "testing"
+
+// TestPartsRoundTrip checks that Parts() decomposes a known-valid IBAN into
+// exactly the fields Compose built it from, for a sample of countries whose
+// CountrySettings.Fields tile the whole bban. Countries with gaps (e.g.
+// Seychelles) cannot go through Compose; see TestPartsLiteral.
+func TestPartsRoundTrip(t *testing.T) {
+	cases := []struct {
+		country string
+		parts   BBANParts
+	}{
+		{"BE", BBANParts{BankCode: "539", AccountNumber: "0075470", NationalCheckDigit: "34"}},
+		{"FI", BBANParts{BankCode: "123456", AccountNumber: "0007854", NationalCheckDigit: "1"}},
+		{"IS", BBANParts{BankCode: "0159", BranchCode: "26", AccountNumber: "007654", NationalCheckDigit: "5510730339"}},
+	}
+
+	for _, c := range cases {
+		iban, err := Compose(c.country, c.parts)
+		if err != nil {
+			t.Fatalf("%s: Compose: %v", c.country, err)
+		}
+
+		if got := iban.Parts(); got != c.parts {
+			t.Fatalf("%s: Parts() = %+v, want %+v", c.country, got, c.parts)
+		}
+	}
+}
+
+// TestPartsLiteral decomposes known real-world IBANs directly, covering
+// countries (like Seychelles) whose Fields do not tile the whole bban and so
+// cannot round-trip through Compose.
+func TestPartsLiteral(t *testing.T) {
+	cases := []struct {
+		code string
+		want BBANParts
+	}{
+		{"SC18SSCB11010000000000001497USD", BBANParts{BankCode: "SSCB", BranchCode: "1101", AccountNumber: "0000000000001497"}},
+	}
+
+	for _, c := range cases {
+		iban, err := NewIBAN(c.code)
+		if err != nil {
+			t.Fatalf("%s: %v", c.code, err)
+		}
+
+		if got := iban.Parts(); got != c.want {
+			t.Fatalf("%s: Parts() = %+v, want %+v", c.code, got, c.want)
+		}
+	}
+}
+
+// TestFormat checks that Format substitutes each placeholder with the
+// corresponding part of the IBAN.
+func TestFormat(t *testing.T) {
+	iban, err := NewIBAN("BE68539007547034")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := iban.Format("{country}{check} {bank} {account} {national}")
+	want := "BE68 539 0075470 34"
+	if got != want {
+		t.Fatalf("Format() = %q, want %q", got, want)
+	}
+}
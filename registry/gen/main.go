@@ -0,0 +1,141 @@
+// Command gen reads the IBAN country registry descriptor (registry/countries.json)
+// and emits iban/countries_gen.go, the generated `countries` map consumed by
+// the iban package. Run via `go generate ./...` from the repository root, or
+// the go:generate directive in iban/iban.go.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"regexp"
+	"sort"
+)
+
+// fieldDescriptor is the JSON shape of a named bban field, mirroring
+// iban.FieldSpec.
+type fieldDescriptor struct {
+	Name  string `json:"name"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+}
+
+// countryDescriptor is the JSON shape of one registry entry. Structure, when
+// present, is the SWIFT `n!/a!/c!` BBAN notation and is translated into the
+// package's internal format string; Format is an internal format string
+// used verbatim, and is only read when Structure is empty.
+type countryDescriptor struct {
+	Code      string            `json:"code"`
+	Length    int               `json:"length"`
+	Structure string            `json:"structure,omitempty"`
+	Format    string            `json:"format,omitempty"`
+	Sepa      bool              `json:"sepa"`
+	Fields    []fieldDescriptor `json:"fields,omitempty"`
+}
+
+var structureToken = regexp.MustCompile(`^(\d+)!([nac])$`)
+
+// toInternalFormat translates a SWIFT BBAN structure such as "4!n4!n12!n"
+// into this package's internal format notation, e.g. "F04F04F12": n (numeric)
+// maps to F, a (upper case alpha) to U, and c (alphanumeric) to A, matching
+// the hand-written entries already in the registry (e.g. Albania's 16!c
+// became A16, not B16) — B is reserved for the stricter upper-case-only
+// alphanumeric class and is never produced from a SWIFT structure today.
+func toInternalFormat(structure string) (string, error) {
+	tokens := regexp.MustCompile(`\d+![nac]`).FindAllString(structure, -1)
+	if len(tokens) == 0 {
+		return "", fmt.Errorf("structure %q has no recognisable n!/a!/c! tokens", structure)
+	}
+
+	var out bytes.Buffer
+	for _, tok := range tokens {
+		m := structureToken.FindStringSubmatch(tok)
+		if m == nil {
+			return "", fmt.Errorf("unrecognised structure token %q", tok)
+		}
+
+		var prefix string
+		switch m[2] {
+		case "n":
+			prefix = "F"
+		case "a":
+			prefix = "U"
+		case "c":
+			prefix = "A"
+		}
+
+		var width int
+		fmt.Sscanf(m[1], "%d", &width)
+		fmt.Fprintf(&out, "%s%02d", prefix, width)
+	}
+
+	return out.String(), nil
+}
+
+func run(input, output string) error {
+	raw, err := os.ReadFile(input)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", input, err)
+	}
+
+	var descriptors []countryDescriptor
+	if err := json.Unmarshal(raw, &descriptors); err != nil {
+		return fmt.Errorf("parsing %s: %w", input, err)
+	}
+
+	sort.Slice(descriptors, func(i, j int) bool { return descriptors[i].Code < descriptors[j].Code })
+
+	var body bytes.Buffer
+	fmt.Fprintf(&body, "// Code generated by registry/gen from registry/countries.json; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&body, "package iban\n\n")
+	fmt.Fprintf(&body, "var countries = map[string]CountrySettings{\n")
+
+	for _, d := range descriptors {
+		format := d.Format
+		if d.Structure != "" {
+			format, err = toInternalFormat(d.Structure)
+			if err != nil {
+				return fmt.Errorf("country %s: %w", d.Code, err)
+			}
+		}
+
+		if format == "" {
+			return fmt.Errorf("country %s: descriptor has neither structure nor format", d.Code)
+		}
+
+		fmt.Fprintf(&body, "\t%q: {Length: %d, Format: %q, Sepa: %t", d.Code, d.Length, format, d.Sepa)
+
+		if len(d.Fields) > 0 {
+			fmt.Fprintf(&body, ", Fields: []FieldSpec{\n")
+			for _, f := range d.Fields {
+				fmt.Fprintf(&body, "\t\t{Name: Field%s, Start: %d, End: %d},\n", f.Name, f.Start, f.End)
+			}
+			fmt.Fprintf(&body, "\t}")
+		}
+
+		fmt.Fprintf(&body, "},\n")
+	}
+
+	fmt.Fprintf(&body, "}\n")
+
+	formatted, err := format.Source(body.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting generated source: %w", err)
+	}
+
+	return os.WriteFile(output, formatted, 0644)
+}
+
+func main() {
+	input := flag.String("input", "countries.json", "path to the country registry descriptor")
+	output := flag.String("output", "countries_gen.go", "path to write the generated Go source to")
+	flag.Parse()
+
+	if err := run(*input, *output); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}